@@ -0,0 +1,35 @@
+package otellog
+
+import "context"
+
+// loggerContextKey is the private context key a *Logger is stored under so
+// that otellog.Ctx can retrieve it without colliding with other packages'
+// context values.
+type loggerContextKey struct{}
+
+// Ctx returns the Logger stored in ctx by a previous call to WithContext, or
+// Default() if ctx carries none. Handler middleware typically stores an
+// enriched Logger once per request; downstream code then logs through the
+// context instead of threading a *Logger argument everywhere:
+//
+//	ctx = log.Default().With(log.Attribute("tenantId", t)).WithContext(ctx)
+//	...
+//	log.Ctx(ctx).Info(ctx, "handled request")
+func Ctx(ctx context.Context) *Logger {
+	if l, ok := ctx.Value(loggerContextKey{}).(*Logger); ok {
+		return l
+	}
+	return std
+}
+
+// WithContext returns a copy of ctx carrying l, unless ctx already carries
+// l itself - in which case ctx is returned unchanged so repeated calls with
+// the same Logger don't grow the context chain. A ctx carrying a different
+// Logger is overridden, so a child created with With can install its own
+// copy for the remainder of a request without mutating the parent's.
+func (l *Logger) WithContext(ctx context.Context) context.Context {
+	if existing, ok := ctx.Value(loggerContextKey{}).(*Logger); ok && existing == l {
+		return ctx
+	}
+	return context.WithValue(ctx, loggerContextKey{}, l)
+}
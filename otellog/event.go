@@ -0,0 +1,64 @@
+package otellog
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Severity mirrors the OpenTelemetry log SeverityNumber: higher values are
+// more severe. The constants fall into the same ranges as the OTEL log data
+// model (TRACE 1-4, DEBUG 5-8, INFO 9-12, WARN 13-16, ERROR 17-20) so events
+// can be forwarded to a collector without translation.
+type Severity int32
+
+const (
+	SeverityDebug Severity = 5
+	SeverityInfo  Severity = 9
+	SeverityWarn  Severity = 13
+	SeverityError Severity = 17
+)
+
+// Event is a single log record shaped after the OTEL log data model. Hooks
+// and Options mutate an Event before it reaches the configured
+// OutputFormatter or, once Sinks are registered, every enabled Sink.
+type Event struct {
+	Time       *time.Time             `json:"time,omitempty"`
+	Severity   Severity               `json:"sev"`
+	Body       string                 `json:"body,omitempty"`
+	Attributes map[string]interface{} `json:"attr,omitempty"`
+
+	// Raw holds already-encoded JSON payloads attached via RawJSON. They
+	// are spliced into the output unchanged because json.RawMessage
+	// implements json.Marshaler as a passthrough.
+	Raw map[string]json.RawMessage `json:"raw,omitempty"`
+
+	// RawCBORFields holds already-encoded CBOR payloads attached via
+	// RawCBOR under the binary_log build tag. They are kept separate from
+	// Attributes, as plain byte strings, so the default CBOR encoder
+	// splices them in without interpreting them and a decoder can always
+	// tell a raw payload apart from a regular attribute by which field it
+	// came from - no CBOR tag number is needed for that. The field is
+	// declared here, untyped, so the JSON-only build does not need to
+	// import a CBOR package just to carry it; it is never populated outside
+	// a binary_log build. The explicit cbor tag matters: fxamacker/cbor
+	// falls back to the json tag when no cbor tag is present, so without
+	// one of its own this field would inherit "-" from a JSON-only
+	// perspective and get silently dropped from the CBOR wire format too.
+	RawCBORFields map[string][]byte `json:"-" cbor:"rawcbor,omitempty"`
+}
+
+// Option mutates an Event before it is written. Options are applied after
+// hooks, so a caller can use one to override or add an attribute on a
+// single call, e.g. log.Info(ctx, "msg", log.Attribute("key", "value")).
+type Option func(e *Event)
+
+// Attribute returns an Option that sets a single key/value pair on the
+// Event's attributes.
+func Attribute(key string, value interface{}) Option {
+	return func(e *Event) {
+		if e.Attributes == nil {
+			e.Attributes = map[string]interface{}{}
+		}
+		e.Attributes[key] = value
+	}
+}
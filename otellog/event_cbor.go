@@ -0,0 +1,29 @@
+//go:build binary_log
+
+package otellog
+
+import "github.com/fxamacker/cbor/v2"
+
+// eventAlias has the same fields as Event but, critically, none of its
+// methods - so cbor.Marshal/Unmarshal encode it field-by-field instead of
+// calling back into MarshalCBOR/UnmarshalCBOR and recursing forever.
+type eventAlias Event
+
+// MarshalCBOR encodes e in the same self-describing CBOR form used by the
+// binary_log OutputFormatter, so hooks and Options that add attributes work
+// unchanged regardless of which wire format is active.
+func (e *Event) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal((*eventAlias)(e))
+}
+
+// UnmarshalCBOR decodes b, as produced by MarshalCBOR, into e. Any RawCBOR
+// payload in b is resolved into a data URL attribute, since a decoded Event
+// is typically headed for a JSON re-encoder (see otellogcbor) that has no
+// other way to carry opaque CBOR bytes.
+func (e *Event) UnmarshalCBOR(b []byte) error {
+	if err := cbor.Unmarshal(b, (*eventAlias)(e)); err != nil {
+		return err
+	}
+	e.resolveRawCBORFields()
+	return nil
+}
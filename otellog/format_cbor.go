@@ -0,0 +1,17 @@
+//go:build binary_log
+
+package otellog
+
+// defaultOutputFormatter marshals an Event as CBOR instead of JSON. It is
+// only compiled in when the binary_log build tag is set, so a plain build
+// stays JSON-only and never pulls in the CBOR dependency.
+var defaultOutputFormatter OutputFormatter = func(e *Event, _ string) ([]byte, error) {
+	return e.MarshalCBOR()
+}
+
+// outputFramedByNewline reports whether a trailing '\n' is a safe record
+// separator for the default OutputFormatter's output. CBOR is binary and
+// can legitimately contain an unescaped 0x0A byte inside a record, so it is
+// not - readers rely on CBOR being self-describing instead (see
+// otellogcbor.Decode).
+const outputFramedByNewline = false
@@ -0,0 +1,18 @@
+//go:build !binary_log
+
+package otellog
+
+import "encoding/json"
+
+// defaultOutputFormatter marshals an Event as JSON. Build with the
+// binary_log tag to switch every Logger's default OutputFormatter to the
+// more compact CBOR encoding instead, without pulling in the CBOR
+// dependency here.
+var defaultOutputFormatter OutputFormatter = func(e *Event, _ string) ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// outputFramedByNewline reports whether a trailing '\n' is a safe, human-
+// readable record separator for the default OutputFormatter's output. JSON
+// text never contains an unescaped newline, so it is.
+const outputFramedByNewline = true
@@ -0,0 +1,26 @@
+//go:build !binary_log
+
+package otellog_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	log "github.com/d-velop/dvelop-sdk-go/otellog"
+)
+
+// These assertions exercise the default JSON OutputFormatter's exact wire
+// shape. They are built only !binary_log because they hardcode JSON bytes;
+// the Logger behavior they also happen to exercise (RawJSON) is covered tag-independently in log_test.go via fakeSink.
+func TestDefaultOutputFormatter_MarshalsEventAsJSON(t *testing.T) {
+	rec := newOutputRecorder(t)
+	l := log.New()
+	l.SetOutput(rec)
+	l.SetTime(func() time.Time {
+		return time.Date(2022, time.January, 01, 1, 2, 3, 4, time.UTC)
+	})
+
+	l.Log(context.Background(), log.SeverityInfo, "Log message", log.RawJSON("payload", []byte(`{"a":1}`)))
+	rec.OutputShouldBe("{\"time\":\"2022-01-01T01:02:03.000000004Z\",\"sev\":9,\"body\":\"Log message\",\"raw\":{\"payload\":{\"a\":1}}}\n")
+}
@@ -0,0 +1,62 @@
+// Package gokit adapts an otellog.Logger to the github.com/go-kit/log
+// Logger interface, so services that mix d.velop SDK code with go-kit based
+// components can route both through the same OTEL-shaped event stream.
+package gokit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/d-velop/dvelop-sdk-go/otellog"
+)
+
+// Logger adapts an *otellog.Logger to github.com/go-kit/log.Logger.
+type Logger struct {
+	logger *otellog.Logger
+}
+
+// NewLogger returns a go-kit log.Logger backed by l.
+func NewLogger(l *otellog.Logger) *Logger {
+	return &Logger{logger: l}
+}
+
+// Log implements github.com/go-kit/log.Logger. keyvals is an alternating
+// list of key/value pairs; a trailing key with no value is logged with the
+// value "MISSING_VALUE". A "level" key of "debug", "info", "warn" or
+// "error" (case-insensitive) picks the Severity the event is logged at;
+// every other key becomes an Event attribute.
+func (l *Logger) Log(keyvals ...interface{}) error {
+	sev := otellog.SeverityInfo
+	opts := make([]otellog.Option, 0, len(keyvals)/2)
+
+	for i := 0; i < len(keyvals); i += 2 {
+		key := fmt.Sprint(keyvals[i])
+		value := interface{}("MISSING_VALUE")
+		if i+1 < len(keyvals) {
+			value = keyvals[i+1]
+		}
+
+		if key == "level" {
+			sev = severityFromLevel(fmt.Sprint(value))
+			continue
+		}
+		opts = append(opts, otellog.Attribute(key, value))
+	}
+
+	l.logger.Log(context.Background(), sev, "", opts...)
+	return nil
+}
+
+func severityFromLevel(level string) otellog.Severity {
+	switch strings.ToLower(level) {
+	case "debug":
+		return otellog.SeverityDebug
+	case "warn", "warning":
+		return otellog.SeverityWarn
+	case "error":
+		return otellog.SeverityError
+	default:
+		return otellog.SeverityInfo
+	}
+}
@@ -0,0 +1,82 @@
+package gokit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/d-velop/dvelop-sdk-go/otellog"
+	"github.com/d-velop/dvelop-sdk-go/otellog/gokit"
+)
+
+// fakeSink records every Event handed to it, so the assertions below check
+// the Event gokit built rather than a particular OutputFormatter's
+// encoding - letting this test run the same way under both the default
+// JSON build and the binary_log (CBOR) build.
+type fakeSink struct {
+	events []*otellog.Event
+}
+
+func (s *fakeSink) Enabled(otellog.Severity) bool { return true }
+
+func (s *fakeSink) Write(_ context.Context, e *otellog.Event) error {
+	s.events = append(s.events, e)
+	return nil
+}
+
+func TestLogger_Log_MapsLevelAndKeyvals(t *testing.T) {
+	testcases := []struct {
+		name         string
+		keyvals      []interface{}
+		wantSeverity otellog.Severity
+		wantAttrs    map[string]interface{}
+	}{
+		{
+			"debug level",
+			[]interface{}{"level", "debug", "msg", "hello"},
+			otellog.SeverityDebug,
+			map[string]interface{}{"msg": "hello"},
+		},
+		{
+			"warn level",
+			[]interface{}{"level", "warn", "msg", "hello"},
+			otellog.SeverityWarn,
+			map[string]interface{}{"msg": "hello"},
+		},
+		{
+			"no level defaults to info",
+			[]interface{}{"msg", "hello"},
+			otellog.SeverityInfo,
+			map[string]interface{}{"msg": "hello"},
+		},
+		{
+			"odd keyvals get MISSING_VALUE",
+			[]interface{}{"msg"},
+			otellog.SeverityInfo,
+			map[string]interface{}{"msg": "MISSING_VALUE"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			l := otellog.New()
+			sink := &fakeSink{}
+			l.AddSink(sink)
+
+			if err := gokit.NewLogger(l).Log(tc.keyvals...); err != nil {
+				t.Fatalf("Log: %v", err)
+			}
+			if len(sink.events) != 1 {
+				t.Fatalf("got %d events, want 1", len(sink.events))
+			}
+			e := sink.events[0]
+			if e.Severity != tc.wantSeverity {
+				t.Errorf("got severity %v, want %v", e.Severity, tc.wantSeverity)
+			}
+			for k, v := range tc.wantAttrs {
+				if e.Attributes[k] != v {
+					t.Errorf("attr %q = %v, want %v", k, e.Attributes[k], v)
+				}
+			}
+		})
+	}
+}
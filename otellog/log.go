@@ -2,7 +2,6 @@ package otellog
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -16,6 +15,11 @@ type Logger struct {
 	outputFormatter OutputFormatter
 	time            Time
 	hooks           []Hook
+	options         []Option
+	sinks           []Sink
+	sampler         Sampler
+	dropped         map[Severity]uint64
+	lastDropReport  time.Time
 }
 
 type Time func() time.Time
@@ -43,11 +47,14 @@ func (l *Logger) Reset() {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 	l.hooks = nil
+	l.options = nil
+	l.sinks = nil
+	l.sampler = nil
+	l.dropped = nil
+	l.lastDropReport = time.Time{}
 	l.out = os.Stdout
 	l.time = time.Now
-	l.outputFormatter = func(e *Event, msg string) ([]byte, error) {
-		return json.Marshal(e)
-	}
+	l.outputFormatter = defaultOutputFormatter
 }
 
 // output writes the output for a logging event.
@@ -66,46 +73,157 @@ func (l *Logger) output(ctx context.Context, sev Severity, msg string, options [
 		h(ctx, &e)
 	}
 
+	for _, o := range l.options {
+		o(&e)
+	}
 	for _, o := range options {
 		o(&e)
 	}
 
-	s, err := l.outputFormatter(&e, msg)
-	if err == nil {
-		if len(s) == 0 || s[len(s)-1] != '\n' {
+	if l.sampler != nil && !l.sampler.Sample(ctx, &e) {
+		l.recordDroppedLocked(ctx, sev)
+		return
+	}
+
+	l.writeLocked(ctx, &e)
+	l.reportDroppedLocked(ctx)
+}
+
+// writeLocked fans e out to the registered sinks, or - if none have been
+// added via AddSink - falls back to the OutputFormatter/io.Writer pipeline
+// configured through SetOutput/SetOutputFormatter.
+func (l *Logger) writeLocked(ctx context.Context, e *Event) {
+	if len(l.sinks) == 0 {
+		s, err := l.outputFormatter(e, e.Body)
+		if err != nil {
+			return
+		}
+		if outputFramedByNewline && (len(s) == 0 || s[len(s)-1] != '\n') {
 			s = append(s, '\n')
 		}
 		l.out.Write(s)
+		return
+	}
+
+	for _, sink := range l.sinks {
+		if sink.Enabled(e.Severity) {
+			sink.Write(ctx, e)
+		}
+	}
+}
+
+// recordDroppedLocked counts a suppressed event and flushes the per-Severity
+// drop counts as Dropped events: right away if no report has been written
+// yet, otherwise once dropReportInterval has passed since the last one.
+func (l *Logger) recordDroppedLocked(ctx context.Context, sev Severity) {
+	if l.dropped == nil {
+		l.dropped = map[Severity]uint64{}
+	}
+	l.dropped[sev]++
+	l.reportDroppedLocked(ctx)
+}
+
+func (l *Logger) reportDroppedLocked(ctx context.Context) {
+	if len(l.dropped) == 0 {
+		return
+	}
+	now := l.time()
+	if !l.lastDropReport.IsZero() && now.Sub(l.lastDropReport) < dropReportInterval {
+		return
+	}
+	l.lastDropReport = now
+
+	for sev, n := range l.dropped {
+		if n == 0 {
+			continue
+		}
+		e := Event{Time: &now, Severity: SeverityInfo, Body: "dropped events due to sampling"}
+		for _, h := range l.hooks {
+			h(ctx, &e)
+		}
+		for _, o := range l.options {
+			o(&e)
+		}
+		Dropped(sev, n)(&e)
+		l.writeLocked(ctx, &e)
+		delete(l.dropped, sev)
+	}
+}
+
+// With returns a child Logger that applies opts to every event it emits, in
+// addition to any options already applied by l. The child starts out with a
+// copy of l's hooks and sinks and l's sampler, taken at the time With is
+// called; later calls to RegisterHook, AddSink or SetSampler on l do not
+// affect an already created child, and appending to the child's own hooks
+// never mutates l's slice.
+func (l *Logger) With(opts ...Option) *Logger {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return &Logger{
+		out:             l.out,
+		outputFormatter: l.outputFormatter,
+		time:            l.time,
+		hooks:           append([]Hook(nil), l.hooks...),
+		options:         append(append([]Option(nil), l.options...), opts...),
+		sinks:           append([]Sink(nil), l.sinks...),
+		sampler:         l.sampler,
+		dropped:         map[Severity]uint64{},
 	}
 }
 
 // SetOutput sets the output destination for the logger.
 func SetOutput(w io.Writer) {
-	std.mu.Lock()
-	defer std.mu.Unlock()
-	std.out = w
+	std.SetOutput(w)
+}
+
+// SetOutput sets the output destination for l.
+func (l *Logger) SetOutput(w io.Writer) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.out = w
 }
 
 // SetTime sets the default clock for outputting the timestamp in the log statement.
 func SetTime(time Time) {
-	std.mu.Lock()
-	defer std.mu.Unlock()
-	std.time = time
+	std.SetTime(time)
+}
+
+// SetTime sets the clock l uses for the timestamp in each event.
+func (l *Logger) SetTime(time Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.time = time
 }
 
 // SetOutputFormatter sets a callback function that will be called when this logger writes a log statement.
 func SetOutputFormatter(f OutputFormatter) {
-	std.mu.Lock()
-	defer std.mu.Unlock()
-	std.outputFormatter = f
+	std.SetOutputFormatter(f)
+}
+
+// SetOutputFormatter sets a callback function that will be called when l
+// writes a log statement.
+func (l *Logger) SetOutputFormatter(f OutputFormatter) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.outputFormatter = f
 }
 
 // RegisterHook adds a callback function that will be called before the logger writes the log statement.
 // Inside the callback function the log event can be extended.
 func RegisterHook(h Hook) {
-	std.mu.Lock()
-	defer std.mu.Unlock()
-	std.hooks = append(std.hooks, h)
+	std.RegisterHook(h)
+}
+
+// RegisterHook adds a callback function that will be called before the
+// logger writes the log statement. Inside the callback function the log
+// event can be extended. It is safe to call concurrently with l's own
+// logging calls, and never mutates the hooks slice a child created via
+// With was given - it only appends to l's own copy.
+func (l *Logger) RegisterHook(h Hook) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.hooks = append(l.hooks, h)
 }
 
 // Debug is equivalent to log.StdDebug.Print()
@@ -137,3 +255,44 @@ func Error(ctx context.Context, v ...interface{}) {
 func Errorf(ctx context.Context, format string, v ...interface{}) {
 	std.output(ctx, SeverityError, fmt.Sprintf(format, v...), nil)
 }
+
+// Log emits msg at sev through l with opts applied, in addition to any
+// attributes l accumulated via With. It is the entry point adapters for
+// other logging interfaces (otellog/gokit, otellog/logr) use to translate
+// their own leveled calls into otellog Events.
+func (l *Logger) Log(ctx context.Context, sev Severity, msg string, opts ...Option) {
+	l.output(ctx, sev, msg, opts)
+}
+
+// Debug logs v at SeverityDebug through l, applying any attributes l
+// accumulated via With.
+func (l *Logger) Debug(ctx context.Context, v ...interface{}) {
+	l.output(ctx, SeverityDebug, fmt.Sprint(v...), nil)
+}
+
+// Debugf logs a formatted message at SeverityDebug through l.
+func (l *Logger) Debugf(ctx context.Context, format string, v ...interface{}) {
+	l.output(ctx, SeverityDebug, fmt.Sprintf(format, v...), nil)
+}
+
+// Info logs v at SeverityInfo through l, applying any attributes l
+// accumulated via With.
+func (l *Logger) Info(ctx context.Context, v ...interface{}) {
+	l.output(ctx, SeverityInfo, fmt.Sprint(v...), nil)
+}
+
+// Infof logs a formatted message at SeverityInfo through l.
+func (l *Logger) Infof(ctx context.Context, format string, v ...interface{}) {
+	l.output(ctx, SeverityInfo, fmt.Sprintf(format, v...), nil)
+}
+
+// Error logs v at SeverityError through l, applying any attributes l
+// accumulated via With.
+func (l *Logger) Error(ctx context.Context, v ...interface{}) {
+	l.output(ctx, SeverityError, fmt.Sprint(v...), nil)
+}
+
+// Errorf logs a formatted message at SeverityError through l.
+func (l *Logger) Errorf(ctx context.Context, format string, v ...interface{}) {
+	l.output(ctx, SeverityError, fmt.Sprintf(format, v...), nil)
+}
@@ -0,0 +1,277 @@
+package otellog_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	log "github.com/d-velop/dvelop-sdk-go/otellog"
+)
+
+// fakeSink records every Event handed to it, so tests can assert on the
+// Event's fields directly instead of on a particular OutputFormatter's
+// encoding - letting them run the same way under both the default JSON
+// build and the binary_log (CBOR) build.
+type fakeSink struct {
+	mu     sync.Mutex
+	events []*log.Event
+	min    log.Severity
+}
+
+func (s *fakeSink) Enabled(sev log.Severity) bool { return sev >= s.min }
+
+func (s *fakeSink) Write(_ context.Context, e *log.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, e)
+	return nil
+}
+
+func TestLogger_Info(t *testing.T) {
+	sink := &fakeSink{}
+	l := log.New()
+	l.AddSink(sink)
+	l.SetTime(func() time.Time {
+		return time.Date(2022, time.January, 01, 1, 2, 3, 4, time.UTC)
+	})
+
+	l.Info(context.Background(), "Log message")
+	if len(sink.events) != 1 {
+		t.Fatalf("got %d events, want 1", len(sink.events))
+	}
+	e := sink.events[0]
+	if e.Severity != log.SeverityInfo || e.Body != "Log message" || !e.Time.Equal(time.Date(2022, time.January, 01, 1, 2, 3, 4, time.UTC)) {
+		t.Fatalf("got %+v", e)
+	}
+}
+
+func TestLogger_RegisterHook(t *testing.T) {
+	sink := &fakeSink{}
+	l := log.New()
+	l.AddSink(sink)
+	l.RegisterHook(func(ctx context.Context, e *log.Event) {
+		log.Attribute("tenantId", "tn1")(e)
+	})
+
+	l.Info(context.Background(), "Log message")
+	if len(sink.events) != 1 || sink.events[0].Attributes["tenantId"] != "tn1" {
+		t.Fatalf("got %+v", sink.events)
+	}
+}
+
+func TestLogger_With_InheritsAndAppliesOptions(t *testing.T) {
+	l := log.New()
+	// With takes a snapshot of l's sinks, so each sink below must be added
+	// after the point the respective Logger was obtained, or it would be
+	// shared between parent and child.
+	child := l.With(log.Attribute("tenantId", "tn1"))
+
+	parentSink := &fakeSink{}
+	l.AddSink(parentSink)
+	childSink := &fakeSink{}
+	child.AddSink(childSink)
+
+	child.Info(context.Background(), "Log message")
+	if len(childSink.events) != 1 || childSink.events[0].Attributes["tenantId"] != "tn1" {
+		t.Fatalf("got %+v", childSink.events)
+	}
+
+	// The parent logger must not have picked up the child's option.
+	l.Info(context.Background(), "Log message")
+	if len(parentSink.events) != 1 || parentSink.events[0].Attributes != nil {
+		t.Fatalf("parent logger picked up the child's option: %+v", parentSink.events)
+	}
+}
+
+func TestLogger_With_ChildHooksDontAffectParent(t *testing.T) {
+	parentRec := newOutputRecorder(t)
+	l := log.New()
+	l.SetOutput(parentRec)
+	child := l.With()
+	child.SetOutput(&bytes.Buffer{})
+
+	child.RegisterHook(func(ctx context.Context, e *log.Event) {
+		log.Attribute("fromChildHook", true)(e)
+	})
+
+	l.Info(context.Background(), "Log message")
+	if bytes.Contains(parentRec.Bytes(), []byte("fromChildHook")) {
+		t.Fatalf("parent logger picked up a hook registered on its child: %q", parentRec.String())
+	}
+}
+
+func TestCtx_ReturnsDefaultWithoutWithContext(t *testing.T) {
+	if log.Ctx(context.Background()) != log.Default() {
+		t.Fatal("Ctx(ctx) should return Default() when ctx carries no Logger")
+	}
+}
+
+func TestLogger_WithContext_RoundTrips(t *testing.T) {
+	l := log.New()
+	ctx := l.WithContext(context.Background())
+	if log.Ctx(ctx) != l {
+		t.Fatal("Ctx(ctx) did not return the Logger stored by WithContext")
+	}
+}
+
+func TestLogger_WithContext_NoopWhenUnchanged(t *testing.T) {
+	l := log.New()
+	ctx := l.WithContext(context.Background())
+	ctx2 := l.WithContext(ctx)
+	if ctx2 != ctx {
+		t.Fatal("WithContext should return ctx unchanged when it already carries l")
+	}
+}
+
+func TestLogger_WithContext_UsableFromCtxInfo(t *testing.T) {
+	sink := &fakeSink{}
+	l := log.New().With(log.Attribute("tenantId", "tn1"))
+	l.AddSink(sink)
+
+	ctx := l.WithContext(context.Background())
+	log.Ctx(ctx).Info(ctx, "Log message")
+	if len(sink.events) != 1 || sink.events[0].Attributes["tenantId"] != "tn1" {
+		t.Fatalf("got %+v", sink.events)
+	}
+}
+
+func TestLogger_AddSink_FansOutAndHonorsEnabled(t *testing.T) {
+	l := log.New()
+	l.SetOutput(&bytes.Buffer{}) // no sinks registered yet: this would be the fallback
+	errsOnly := &fakeSink{min: log.SeverityError}
+	everything := &fakeSink{}
+	l.AddSink(errsOnly)
+	l.AddSink(everything)
+
+	l.Info(context.Background(), "info message")
+	l.Error(context.Background(), "error message")
+
+	if len(errsOnly.events) != 1 {
+		t.Fatalf("sink with min=Error got %d events, want 1", len(errsOnly.events))
+	}
+	if len(everything.events) != 2 {
+		t.Fatalf("sink with no min got %d events, want 2", len(everything.events))
+	}
+}
+
+func TestRateLimiter_BurstThenSamples(t *testing.T) {
+	rl := log.NewRateLimiter(map[log.Severity]log.RateLimit{
+		log.SeverityDebug: {Burst: 2, SampleRate: 0},
+	})
+
+	e := &log.Event{Severity: log.SeverityDebug}
+	var kept int
+	for i := 0; i < 5; i++ {
+		if rl.Sample(context.Background(), e) {
+			kept++
+		}
+	}
+	if kept != 2 {
+		t.Fatalf("got %d kept events within the burst window, want 2", kept)
+	}
+
+	// Severities with no configured limit are always kept.
+	if !rl.Sample(context.Background(), &log.Event{Severity: log.SeverityError}) {
+		t.Fatal("severity with no RateLimit configured should always be sampled")
+	}
+}
+
+func TestLogger_SetSampler_DropsSuppressedEvents(t *testing.T) {
+	sink := &fakeSink{}
+	l := log.New()
+	l.AddSink(sink)
+	l.SetSampler(log.SamplerFunc(func(ctx context.Context, e *log.Event) bool {
+		return e.Body == "keep me"
+	}))
+
+	l.Info(context.Background(), "drop me")
+	l.Info(context.Background(), "keep me")
+
+	var sawKept, sawDropped bool
+	for _, e := range sink.events {
+		switch e.Body {
+		case "keep me":
+			sawKept = true
+		case "drop me":
+			sawDropped = true
+		}
+	}
+	if sawDropped {
+		t.Fatalf("sampler-suppressed event was written: %+v", sink.events)
+	}
+	if !sawKept {
+		t.Fatalf("sampled-in event was not written: %+v", sink.events)
+	}
+}
+
+func TestLogger_SetSampler_DropReportCarriesLoggerOptions(t *testing.T) {
+	sink := &fakeSink{}
+	l := log.New().With(log.Attribute("tenantId", "tn1"))
+	l.AddSink(sink)
+	l.SetSampler(log.SamplerFunc(func(ctx context.Context, e *log.Event) bool { return false }))
+
+	l.Info(context.Background(), "dropped")
+
+	if len(sink.events) != 1 {
+		t.Fatalf("got %d events, want 1 drop report", len(sink.events))
+	}
+	if sink.events[0].Attributes["tenantId"] != "tn1" {
+		t.Fatalf("drop report did not carry the logger's own options: %+v", sink.events[0])
+	}
+}
+
+func TestRawJSON_SplicesValidPayloadUnchanged(t *testing.T) {
+	sink := &fakeSink{}
+	l := log.New()
+	l.AddSink(sink)
+
+	l.Log(context.Background(), log.SeverityInfo, "Log message", log.RawJSON("payload", []byte(`{"a":1}`)))
+	if len(sink.events) != 1 {
+		t.Fatalf("got %d events, want 1", len(sink.events))
+	}
+	raw, ok := sink.events[0].Raw["payload"]
+	if !ok {
+		t.Fatalf("Raw[\"payload\"] missing: %+v", sink.events[0])
+	}
+	var got map[string]int
+	if err := json.Unmarshal(raw, &got); err != nil || got["a"] != 1 {
+		t.Fatalf("Raw[\"payload\"] = %s, want {\"a\":1}", raw)
+	}
+}
+
+func TestRawJSON_MalformedPayloadDoesNotDropTheEvent(t *testing.T) {
+	sink := &fakeSink{}
+	l := log.New()
+	l.AddSink(sink)
+
+	l.Log(context.Background(), log.SeverityInfo, "Log message", log.RawJSON("payload", []byte("{not valid")))
+	if len(sink.events) != 1 {
+		t.Fatalf("got %d events, want 1", len(sink.events))
+	}
+	e := sink.events[0]
+	if _, stillRaw := e.Raw["payload"]; stillRaw {
+		t.Fatalf("malformed payload should not have reached Raw: %+v", e)
+	}
+	if e.Attributes["payload"] != "otellog: RawJSON: not valid JSON" {
+		t.Fatalf("got %+v", e.Attributes)
+	}
+}
+
+type outputRecorder struct {
+	*bytes.Buffer
+	t *testing.T
+}
+
+func newOutputRecorder(t *testing.T) *outputRecorder {
+	return &outputRecorder{&bytes.Buffer{}, t}
+}
+
+func (o *outputRecorder) OutputShouldBe(expected string) {
+	actual := o.String()
+	if actual != expected {
+		o.t.Errorf("\ngot   :'%v'\nwanted:'%v'", actual, expected)
+	}
+}
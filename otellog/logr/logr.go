@@ -0,0 +1,80 @@
+// Package logr implements a github.com/go-logr/logr.LogSink backed by an
+// otellog.Logger, so controller-runtime and klog-based code can write into
+// the same OTEL-shaped event stream as the rest of a service.
+package logr
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/d-velop/dvelop-sdk-go/otellog"
+	"github.com/go-logr/logr"
+)
+
+// NewLogSink returns a logr.LogSink backed by l. V(n) maps to
+// otellog.SeverityInfo for n==0 and otellog.SeverityDebug for n>0; Error
+// always logs at otellog.SeverityError with err attached as the "err"
+// attribute.
+func NewLogSink(l *otellog.Logger) logr.LogSink {
+	return &sink{logger: l}
+}
+
+type sink struct {
+	logger *otellog.Logger
+	name   string
+	values []interface{}
+}
+
+var _ logr.LogSink = (*sink)(nil)
+
+func (s *sink) Init(logr.RuntimeInfo) {}
+
+func (s *sink) Enabled(level int) bool { return true }
+
+func (s *sink) Info(level int, msg string, keysAndValues ...interface{}) {
+	sev := otellog.SeverityInfo
+	if level > 0 {
+		sev = otellog.SeverityDebug
+	}
+	s.logger.Log(context.Background(), sev, msg, s.options(keysAndValues)...)
+}
+
+func (s *sink) Error(err error, msg string, keysAndValues ...interface{}) {
+	var loggableErr interface{}
+	if err != nil {
+		loggableErr = err.Error()
+	}
+	opts := append(s.options(keysAndValues), otellog.Attribute("err", loggableErr))
+	s.logger.Log(context.Background(), otellog.SeverityError, msg, opts...)
+}
+
+func (s *sink) WithValues(keysAndValues ...interface{}) logr.LogSink {
+	return &sink{
+		logger: s.logger,
+		name:   s.name,
+		values: append(append([]interface{}(nil), s.values...), keysAndValues...),
+	}
+}
+
+func (s *sink) WithName(name string) logr.LogSink {
+	fullName := name
+	if s.name != "" {
+		fullName = s.name + "." + name
+	}
+	return &sink{logger: s.logger, name: fullName, values: s.values}
+}
+
+// options turns the sink's accumulated WithValues pairs plus the call's own
+// keysAndValues into otellog Options, prefixing a "logger" attribute when
+// WithName was used.
+func (s *sink) options(keysAndValues []interface{}) []otellog.Option {
+	all := append(append([]interface{}(nil), s.values...), keysAndValues...)
+	opts := make([]otellog.Option, 0, len(all)/2+1)
+	if s.name != "" {
+		opts = append(opts, otellog.Attribute("logger", s.name))
+	}
+	for i := 0; i+1 < len(all); i += 2 {
+		opts = append(opts, otellog.Attribute(fmt.Sprint(all[i]), all[i+1]))
+	}
+	return opts
+}
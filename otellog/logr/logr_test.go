@@ -0,0 +1,104 @@
+package logr_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/d-velop/dvelop-sdk-go/otellog"
+	otellogr "github.com/d-velop/dvelop-sdk-go/otellog/logr"
+)
+
+// fakeSink records every Event handed to it, so the assertions below check
+// the Event the sink built rather than a particular OutputFormatter's
+// encoding - letting this test run the same way under both the default
+// JSON build and the binary_log (CBOR) build.
+type fakeSink struct {
+	events []*otellog.Event
+}
+
+func (s *fakeSink) Enabled(otellog.Severity) bool { return true }
+
+func (s *fakeSink) Write(_ context.Context, e *otellog.Event) error {
+	s.events = append(s.events, e)
+	return nil
+}
+
+func TestLogSink_Info(t *testing.T) {
+	l := otellog.New()
+	sink := &fakeSink{}
+	l.AddSink(sink)
+
+	otellogr.NewLogSink(l).Info(0, "hello", "k", "v")
+
+	if len(sink.events) != 1 {
+		t.Fatalf("got %d events, want 1", len(sink.events))
+	}
+	e := sink.events[0]
+	if e.Severity != otellog.SeverityInfo || e.Body != "hello" || e.Attributes["k"] != "v" {
+		t.Fatalf("got %+v", e)
+	}
+}
+
+func TestLogSink_Info_VGreaterThanZeroIsDebug(t *testing.T) {
+	l := otellog.New()
+	sink := &fakeSink{}
+	l.AddSink(sink)
+
+	otellogr.NewLogSink(l).Info(1, "hello")
+
+	if len(sink.events) != 1 || sink.events[0].Severity != otellog.SeverityDebug {
+		t.Fatalf("got %+v", sink.events)
+	}
+}
+
+func TestLogSink_Error_AttachesErrAttribute(t *testing.T) {
+	l := otellog.New()
+	sink := &fakeSink{}
+	l.AddSink(sink)
+
+	otellogr.NewLogSink(l).Error(errors.New("boom"), "hello")
+
+	if len(sink.events) != 1 {
+		t.Fatalf("got %d events, want 1", len(sink.events))
+	}
+	e := sink.events[0]
+	if e.Severity != otellog.SeverityError || e.Body != "hello" || e.Attributes["err"] != "boom" {
+		t.Fatalf("got %+v", e)
+	}
+}
+
+// The reference go-logr funcr implementation allows Error to be called with
+// a nil err, and expects the sink not to panic on it.
+func TestLogSink_Error_NilErrDoesNotPanic(t *testing.T) {
+	l := otellog.New()
+	sink := &fakeSink{}
+	l.AddSink(sink)
+
+	otellogr.NewLogSink(l).Error(nil, "hello")
+
+	if len(sink.events) != 1 {
+		t.Fatalf("got %d events, want 1", len(sink.events))
+	}
+	e := sink.events[0]
+	if e.Severity != otellog.SeverityError || e.Body != "hello" || e.Attributes["err"] != nil {
+		t.Fatalf("got %+v", e)
+	}
+}
+
+func TestLogSink_WithNameAndWithValues(t *testing.T) {
+	l := otellog.New()
+	sink := &fakeSink{}
+	l.AddSink(sink)
+
+	s := otellogr.NewLogSink(l).WithName("controller").WithValues("reqId", "r1")
+	s.Info(0, "hello")
+
+	if len(sink.events) != 1 {
+		t.Fatalf("got %d events, want 1", len(sink.events))
+	}
+	e := sink.events[0]
+	if e.Attributes["logger"] != "controller" || e.Attributes["reqId"] != "r1" {
+		t.Fatalf("got %+v", e)
+	}
+}
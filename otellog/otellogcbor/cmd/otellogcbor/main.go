@@ -0,0 +1,26 @@
+//go:build binary_log
+
+// Command otellogcbor re-emits a CBOR log stream produced by the binary_log
+// build of otellog as newline-delimited JSON for local debugging.
+//
+//	otellogcbor decode < file.cbor
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/d-velop/dvelop-sdk-go/otellog/otellogcbor"
+)
+
+func main() {
+	if len(os.Args) != 2 || os.Args[1] != "decode" {
+		fmt.Fprintln(os.Stderr, "usage: otellogcbor decode < file.cbor")
+		os.Exit(2)
+	}
+
+	if err := otellogcbor.Decode(os.Stdout, os.Stdin); err != nil {
+		fmt.Fprintln(os.Stderr, "otellogcbor: decode:", err)
+		os.Exit(1)
+	}
+}
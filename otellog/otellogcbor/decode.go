@@ -0,0 +1,40 @@
+//go:build binary_log
+
+// Package otellogcbor decodes the CBOR-encoded otellog.Event stream
+// produced by a Logger built with the binary_log tag, re-emitting it as
+// newline-delimited JSON for local debugging with tools that only
+// understand the text format.
+package otellogcbor
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/d-velop/dvelop-sdk-go/otellog"
+	"github.com/fxamacker/cbor/v2"
+)
+
+// Decode reads a back-to-back stream of CBOR-encoded Events from r - as
+// written by the binary_log OutputFormatter, with no added framing between
+// records - and writes each one to w as a line of JSON. CBOR is
+// self-describing, so the decoder knows where each Event ends without a
+// delimiter; a delimiter such as a newline could not be used safely here
+// since CBOR's encoded bytes can legitimately contain 0x0A in the middle of
+// a record.
+func Decode(w io.Writer, r io.Reader) error {
+	dec := cbor.NewDecoder(r)
+	enc := json.NewEncoder(w)
+
+	for {
+		var e otellog.Event
+		if err := dec.Decode(&e); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if err := enc.Encode(&e); err != nil {
+			return err
+		}
+	}
+}
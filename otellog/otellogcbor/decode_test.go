@@ -0,0 +1,59 @@
+//go:build binary_log
+
+package otellogcbor_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/d-velop/dvelop-sdk-go/otellog"
+	"github.com/d-velop/dvelop-sdk-go/otellog/otellogcbor"
+	"github.com/fxamacker/cbor/v2"
+)
+
+func TestDecode_RoundTripsManyEvents(t *testing.T) {
+	l := otellog.New()
+	var wire bytes.Buffer
+	l.SetOutput(&wire)
+	l.SetTime(func() time.Time { return time.Unix(0, 0) })
+
+	// Long, varied bodies make it likely the CBOR encoding of at least one
+	// event contains a raw 0x0A byte, exercising the fact that Decode does
+	// not rely on newline framing.
+	body := strings.Repeat("the quick brown fox jumps over the lazy dog 0123456789 ", 50)
+	const n = 200
+	for i := 0; i < n; i++ {
+		l.Info(nil, body, i)
+	}
+
+	var out bytes.Buffer
+	if err := otellogcbor.Decode(&out, &wire); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if got := strings.Count(out.String(), "\n"); got != n {
+		t.Fatalf("got %d decoded events, want %d", got, n)
+	}
+}
+
+func TestDecode_RawCBORBecomesDataURL(t *testing.T) {
+	l := otellog.New()
+	var wire bytes.Buffer
+	l.SetOutput(&wire)
+	l.SetTime(func() time.Time { return time.Unix(0, 0) })
+
+	payload, err := cbor.Marshal(map[string]string{"webhook": "body"})
+	if err != nil {
+		t.Fatalf("cbor.Marshal: %v", err)
+	}
+	l.Log(nil, otellog.SeverityInfo, "audit", otellog.RawCBOR("payload", payload))
+
+	var out bytes.Buffer
+	if err := otellogcbor.Decode(&out, &wire); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if !strings.Contains(out.String(), "data:application/cbor;base64,") {
+		t.Fatalf("expected a data URL in decoded output, got %q", out.String())
+	}
+}
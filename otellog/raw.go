@@ -0,0 +1,31 @@
+package otellog
+
+import "encoding/json"
+
+// RawJSON returns an Option that attaches an already-encoded JSON payload
+// under key, splicing b directly into the output instead of decoding and
+// re-encoding it. This matters on hot paths that log large request/
+// response envelopes for audit, where the caller already holds a JSON
+// document - an incoming webhook body, a serialized protobuf-as-JSON
+// message - and paying a decode-then-re-encode round trip on every call
+// would be wasteful.
+//
+// b must be well-formed JSON. RawJSON validates it once, here, regardless
+// of build mode: encoding/json has no way to splice raw bytes without
+// validating them first, so there is no cheaper release-mode path to skip
+// to, and silently trusting malformed input would make the OutputFormatter
+// fail and the whole Event vanish instead of just this one attribute. On
+// malformed input, key is logged with an error message in place of the raw
+// payload.
+func RawJSON(key string, b []byte) Option {
+	return func(e *Event) {
+		if !json.Valid(b) {
+			Attribute(key, "otellog: RawJSON: not valid JSON")(e)
+			return
+		}
+		if e.Raw == nil {
+			e.Raw = map[string]json.RawMessage{}
+		}
+		e.Raw[key] = b
+	}
+}
@@ -0,0 +1,32 @@
+//go:build binary_log
+
+package otellog
+
+import "encoding/base64"
+
+// RawCBOR returns an Option that attaches an already CBOR-encoded payload
+// under key, splicing b directly into the output instead of decoding and
+// re-encoding it - the same optimization RawJSON offers, for the binary_log
+// build. b must be a single, well-formed CBOR data item.
+func RawCBOR(key string, b []byte) Option {
+	return func(e *Event) {
+		if e.RawCBORFields == nil {
+			e.RawCBORFields = map[string][]byte{}
+		}
+		e.RawCBORFields[key] = b
+	}
+}
+
+// resolveRawCBORFields turns every RawCBOR payload surviving a round trip
+// through UnmarshalCBOR into a data URL Attribute, so code that only
+// understands the plain Event.Attributes/Raw shape - like otellogcbor's
+// JSON re-encoder - can still carry the payload without decoding it.
+func (e *Event) resolveRawCBORFields() {
+	for key, b := range e.RawCBORFields {
+		if e.Attributes == nil {
+			e.Attributes = map[string]interface{}{}
+		}
+		e.Attributes[key] = "data:application/cbor;base64," + base64.StdEncoding.EncodeToString(b)
+	}
+	e.RawCBORFields = nil
+}
@@ -0,0 +1,110 @@
+package otellog
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Sampler decides whether an event should be forwarded to the registered
+// Sinks. It runs after hooks and Options have been applied, so a Sampler
+// can inspect the fully populated Event - or the context - to make
+// tail-based decisions, e.g. always keeping events that belong to a sampled
+// trace span.
+type Sampler interface {
+	Sample(ctx context.Context, e *Event) bool
+}
+
+// SamplerFunc adapts a function to a Sampler.
+type SamplerFunc func(ctx context.Context, e *Event) bool
+
+func (f SamplerFunc) Sample(ctx context.Context, e *Event) bool {
+	return f(ctx, e)
+}
+
+// SetSampler sets the Sampler used by the default logger to decide whether
+// an event is dropped before it reaches any Sink. A nil Sampler (the
+// default) keeps every event.
+func SetSampler(s Sampler) {
+	std.SetSampler(s)
+}
+
+// SetSampler sets the Sampler used to decide whether an event is dropped
+// before it reaches any Sink. A nil Sampler (the default) keeps every
+// event.
+func (l *Logger) SetSampler(s Sampler) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sampler = s
+}
+
+// RateLimit configures how many events of a given Severity are let through
+// per second.
+type RateLimit struct {
+	// Burst is the number of events per second that are always kept.
+	Burst int
+	// SampleRate keeps one in SampleRate events once Burst is spent for
+	// that second. A SampleRate of 0 or 1 drops every event once the burst
+	// is spent.
+	SampleRate int
+}
+
+// RateLimiter is a Sampler that applies a token-bucket burst followed by a
+// random 1-in-N sample per Severity, the same strategy zerolog's
+// BurstSampler uses to keep hot loops from flooding an OTEL collector.
+// Severities with no configured RateLimit are always kept.
+type RateLimiter struct {
+	limits map[Severity]RateLimit
+
+	mu      sync.Mutex
+	buckets map[Severity]*rateLimitBucket
+}
+
+type rateLimitBucket struct {
+	tokens   int
+	resetsAt time.Time
+}
+
+// NewRateLimiter creates a RateLimiter with the given per-Severity limits.
+func NewRateLimiter(limits map[Severity]RateLimit) *RateLimiter {
+	return &RateLimiter{limits: limits, buckets: map[Severity]*rateLimitBucket{}}
+}
+
+func (r *RateLimiter) Sample(_ context.Context, e *Event) bool {
+	limit, ok := r.limits[e.Severity]
+	if !ok {
+		return true
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	b, ok := r.buckets[e.Severity]
+	if !ok || !now.Before(b.resetsAt) {
+		b = &rateLimitBucket{tokens: limit.Burst, resetsAt: now.Add(time.Second)}
+		r.buckets[e.Severity] = b
+	}
+	if b.tokens > 0 {
+		b.tokens--
+		return true
+	}
+	if limit.SampleRate <= 1 {
+		return false
+	}
+	return rand.Intn(limit.SampleRate) == 0
+}
+
+// dropReportInterval is how often a logger with a Sampler configured emits
+// a summary event for the events it suppressed.
+const dropReportInterval = 10 * time.Second
+
+// Dropped returns an Option that records how many events of the given
+// Severity were suppressed by the Sampler since the last report.
+func Dropped(sev Severity, n uint64) Option {
+	return func(e *Event) {
+		Attribute("dropped.severity", sev)(e)
+		Attribute("dropped.count", n)(e)
+	}
+}
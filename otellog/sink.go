@@ -0,0 +1,60 @@
+package otellog
+
+import (
+	"context"
+	"io"
+)
+
+// Sink is a destination for log events. A Logger fans every emitted event
+// out to each registered Sink, instead of the single OutputFormatter+
+// io.Writer pipeline it used before Sinks existed.
+type Sink interface {
+	// Write persists the event. It is only called for sinks whose Enabled
+	// returned true for the event's Severity.
+	Write(ctx context.Context, e *Event) error
+	// Enabled reports whether the sink wants events of the given severity,
+	// so a sink that e.g. only forwards errors to an alerting backend can
+	// avoid the cost of Write entirely.
+	Enabled(sev Severity) bool
+}
+
+// WriterSink adapts an io.Writer and an OutputFormatter to the Sink
+// interface. It is equivalent to the pipeline SetOutput/SetOutputFormatter
+// configured before Sinks were introduced, and can be registered alongside
+// other sinks via AddSink.
+type WriterSink struct {
+	Writer      io.Writer
+	Formatter   OutputFormatter
+	MinSeverity Severity
+}
+
+func (s *WriterSink) Enabled(sev Severity) bool {
+	return sev >= s.MinSeverity
+}
+
+func (s *WriterSink) Write(_ context.Context, e *Event) error {
+	b, err := s.Formatter(e, e.Body)
+	if err != nil {
+		return err
+	}
+	if outputFramedByNewline && (len(b) == 0 || b[len(b)-1] != '\n') {
+		b = append(b, '\n')
+	}
+	_, err = s.Writer.Write(b)
+	return err
+}
+
+// AddSink registers an additional Sink on the default logger. Every
+// subsequent event is fanned out to it alongside any other registered
+// sinks.
+func AddSink(s Sink) {
+	std.AddSink(s)
+}
+
+// AddSink registers an additional Sink. Every subsequent event is fanned
+// out to it alongside any other registered sinks.
+func (l *Logger) AddSink(s Sink) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sinks = append(l.sinks, s)
+}